@@ -1,13 +1,16 @@
 package channel
 
 import (
+	"container/list"
 	"encoding/json"
 	"fmt"
 	"gpt-load/internal/config"
 	"gpt-load/internal/httpclient"
 	"gpt-load/internal/models"
+	"gpt-load/internal/types"
 	"gpt-load/internal/utils"
 	"net/url"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,29 +33,56 @@ func Register(channelType string, constructor channelConstructor) {
 	channelRegistry[channelType] = constructor
 }
 
-// GetChannels returns a slice of all registered channel type names.
+// GetChannels returns a sorted slice of all registered channel type names.
 func GetChannels() []string {
 	supportedTypes := make([]string, 0, len(channelRegistry))
 	for t := range channelRegistry {
 		supportedTypes = append(supportedTypes, t)
 	}
+	sort.Strings(supportedTypes)
 	return supportedTypes
 }
 
+// cacheEntry is a single item in the factory's LRU channel cache.
+type cacheEntry struct {
+	groupID   uint
+	channel   ChannelProxy
+	createdAt time.Time
+}
+
+// CacheStats is a snapshot of the factory's channel cache counters.
+type CacheStats struct {
+	Size            int    `json:"size"`
+	Capacity        int    `json:"capacity"`
+	Hits            uint64 `json:"hits"`
+	Misses          uint64 `json:"misses"`
+	StaleRebuilds   uint64 `json:"stale_rebuilds"`
+	ExpiredRebuilds uint64 `json:"expired_rebuilds"`
+	Evictions       uint64 `json:"evictions"`
+}
+
 // Factory is responsible for creating channel proxies.
 type Factory struct {
 	settingsManager *config.SystemSettingsManager
 	clientManager   *httpclient.HTTPClientManager
-	channelCache    map[uint]ChannelProxy
+	cacheCapacity   int
+	cacheTTL        time.Duration
+	channelCache    map[uint]*list.Element
+	lruList         *list.List
 	cacheLock       sync.Mutex
+	stats           CacheStats
 }
 
 // NewFactory creates a new channel factory.
-func NewFactory(settingsManager *config.SystemSettingsManager, clientManager *httpclient.HTTPClientManager) *Factory {
+func NewFactory(settingsManager *config.SystemSettingsManager, clientManager *httpclient.HTTPClientManager, configManager types.ConfigManager) *Factory {
+	perfConfig := configManager.GetPerformanceConfig()
 	return &Factory{
 		settingsManager: settingsManager,
 		clientManager:   clientManager,
-		channelCache:    make(map[uint]ChannelProxy),
+		cacheCapacity:   perfConfig.ChannelCacheSize,
+		cacheTTL:        time.Duration(perfConfig.ChannelCacheTTL) * time.Second,
+		channelCache:    make(map[uint]*list.Element),
+		lruList:         list.New(),
 	}
 }
 
@@ -61,10 +91,20 @@ func (f *Factory) GetChannel(group *models.Group) (ChannelProxy, error) {
 	f.cacheLock.Lock()
 	defer f.cacheLock.Unlock()
 
-	if channel, ok := f.channelCache[group.ID]; ok {
-		if !channel.IsConfigStale(group) {
-			return channel, nil
+	if elem, ok := f.channelCache[group.ID]; ok {
+		entry := elem.Value.(*cacheEntry)
+		switch {
+		case entry.channel.IsConfigStale(group):
+			f.stats.StaleRebuilds++
+		case f.isExpired(entry):
+			f.stats.ExpiredRebuilds++
+		default:
+			f.stats.Hits++
+			f.lruList.MoveToFront(elem)
+			return entry.channel, nil
 		}
+	} else {
+		f.stats.Misses++
 	}
 
 	logrus.Debugf("Creating new channel for group %d with type '%s'", group.ID, group.ChannelType)
@@ -77,10 +117,50 @@ func (f *Factory) GetChannel(group *models.Group) (ChannelProxy, error) {
 	if err != nil {
 		return nil, err
 	}
-	f.channelCache[group.ID] = channel
+	f.putChannel(group.ID, channel)
 	return channel, nil
 }
 
+// isExpired reports whether a cached channel has outlived the configured cache TTL.
+// A zero TTL disables age-based expiry.
+func (f *Factory) isExpired(entry *cacheEntry) bool {
+	return f.cacheTTL > 0 && time.Since(entry.createdAt) > f.cacheTTL
+}
+
+// putChannel stores a channel in the cache, evicting the least recently used entry when full.
+// The caller must hold cacheLock.
+func (f *Factory) putChannel(groupID uint, channel ChannelProxy) {
+	if elem, ok := f.channelCache[groupID]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.channel = channel
+		entry.createdAt = time.Now()
+		f.lruList.MoveToFront(elem)
+		return
+	}
+
+	f.channelCache[groupID] = f.lruList.PushFront(&cacheEntry{groupID: groupID, channel: channel, createdAt: time.Now()})
+
+	for f.cacheCapacity > 0 && f.lruList.Len() > f.cacheCapacity {
+		oldest := f.lruList.Back()
+		entry := oldest.Value.(*cacheEntry)
+		f.lruList.Remove(oldest)
+		delete(f.channelCache, entry.groupID)
+		f.stats.Evictions++
+		logrus.Debugf("Evicted channel for group %d from cache", entry.groupID)
+	}
+}
+
+// Stats returns a snapshot of the channel cache counters.
+func (f *Factory) Stats() CacheStats {
+	f.cacheLock.Lock()
+	defer f.cacheLock.Unlock()
+
+	stats := f.stats
+	stats.Size = f.lruList.Len()
+	stats.Capacity = f.cacheCapacity
+	return stats
+}
+
 // newBaseChannel is a helper function to create and configure a BaseChannel.
 func (f *Factory) newBaseChannel(name string, group *models.Group) (*BaseChannel, error) {
 	type upstreamDef struct {
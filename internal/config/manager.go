@@ -91,6 +91,8 @@ func (m *Manager) ReloadConfig() error {
 		},
 		Performance: types.PerformanceConfig{
 			MaxConcurrentRequests: utils.ParseInteger(os.Getenv("MAX_CONCURRENT_REQUESTS"), 100),
+			ChannelCacheSize:      utils.ParseInteger(os.Getenv("CHANNEL_CACHE_SIZE"), 1000),
+			ChannelCacheTTL:       utils.ParseInteger(os.Getenv("CHANNEL_CACHE_TTL"), 0),
 		},
 		Log: types.LogConfig{
 			Level:      utils.GetEnvOrDefault("LOG_LEVEL", "info"),
@@ -172,6 +174,14 @@ func (m *Manager) Validate() error {
 		validationErrors = append(validationErrors, "max concurrent requests cannot be less than 1")
 	}
 
+	if m.config.Performance.ChannelCacheSize < 1 {
+		validationErrors = append(validationErrors, "channel cache size cannot be less than 1")
+	}
+
+	if m.config.Performance.ChannelCacheTTL < 0 {
+		validationErrors = append(validationErrors, "channel cache TTL cannot be negative")
+	}
+
 	// Validate auth key
 	if m.config.Auth.Key == "" {
 		validationErrors = append(validationErrors, "AUTH_KEY is required and cannot be empty")
@@ -225,6 +235,12 @@ func (m *Manager) DisplayServerConfig() {
 
 	logrus.Info("  --- Performance ---")
 	logrus.Infof("    Max Concurrent Requests: %d", perfConfig.MaxConcurrentRequests)
+	logrus.Infof("    Channel Cache Size: %d", perfConfig.ChannelCacheSize)
+	if perfConfig.ChannelCacheTTL > 0 {
+		logrus.Infof("    Channel Cache TTL: %d seconds", perfConfig.ChannelCacheTTL)
+	} else {
+		logrus.Info("    Channel Cache TTL: disabled")
+	}
 
 	logrus.Info("  --- Security ---")
 	logrus.Infof("    Authentication: enabled (key loaded)")
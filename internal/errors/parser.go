@@ -3,6 +3,7 @@ package errors
 import (
 	"encoding/json"
 	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -70,10 +71,14 @@ func ParseUpstreamError(body []byte) string {
 	return truncateString(string(body), maxErrorBodyLength)
 }
 
-// truncateString ensures a string does not exceed a maximum length.
+// truncateString ensures a string does not exceed a maximum length in bytes,
+// cutting on a rune boundary so a multi-byte UTF-8 character is never split.
 func truncateString(s string, maxLength int) string {
-	if len(s) > maxLength {
-		return s[:maxLength]
+	if len(s) <= maxLength {
+		return s
 	}
-	return s
+	for maxLength > 0 && !utf8.RuneStart(s[maxLength]) {
+		maxLength--
+	}
+	return s[:maxLength]
 }
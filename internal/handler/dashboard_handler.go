@@ -414,6 +414,11 @@ func (s *Server) EncryptionStatus(c *gin.Context) {
 	})
 }
 
+// ChannelCacheStats returns the channel factory's cache counters
+func (s *Server) ChannelCacheStats(c *gin.Context) {
+	response.Success(c, s.ChannelFactory.Stats())
+}
+
 // checkEncryptionMismatch detects encryption configuration mismatches
 func (s *Server) checkEncryptionMismatch(c *gin.Context) (bool, string, string, string) {
 	encryptionKey := s.config.GetEncryptionKey()
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"gpt-load/internal/channel"
 	"gpt-load/internal/config"
 	"gpt-load/internal/encryption"
 	"gpt-load/internal/i18n"
@@ -33,6 +34,7 @@ type Server struct {
 	LogService                 *services.LogService
 	CommonHandler              *CommonHandler
 	EncryptionSvc              encryption.Service
+	ChannelFactory             *channel.Factory
 }
 
 // NewServerParams defines the dependencies for the NewServer constructor.
@@ -52,6 +54,7 @@ type NewServerParams struct {
 	LogService                 *services.LogService
 	CommonHandler              *CommonHandler
 	EncryptionSvc              encryption.Service
+	ChannelFactory             *channel.Factory
 }
 
 // NewServer creates a new handler instance with dependencies injected by dig.
@@ -71,6 +74,7 @@ func NewServer(params NewServerParams) *Server {
 		LogService:                 params.LogService,
 		CommonHandler:              params.CommonHandler,
 		EncryptionSvc:              params.EncryptionSvc,
+		ChannelFactory:             params.ChannelFactory,
 	}
 }
 
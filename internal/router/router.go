@@ -152,6 +152,7 @@ func registerProtectedAPIRoutes(api *gin.RouterGroup, serverHandler *handler.Ser
 		dashboard.GET("/stats", serverHandler.Stats)
 		dashboard.GET("/chart", serverHandler.Chart)
 		dashboard.GET("/encryption-status", serverHandler.EncryptionStatus)
+		dashboard.GET("/channel-cache", serverHandler.ChannelCacheStats)
 	}
 
 	// 日志
@@ -73,6 +73,8 @@ type CORSConfig struct {
 // PerformanceConfig represents performance configuration
 type PerformanceConfig struct {
 	MaxConcurrentRequests int `json:"max_concurrent_requests"`
+	ChannelCacheSize      int `json:"channel_cache_size"`
+	ChannelCacheTTL       int `json:"channel_cache_ttl"`
 }
 
 // LogConfig represents logging configuration
@@ -3,6 +3,7 @@ package utils
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 // MaskAPIKey masks an API key for safe logging.
@@ -14,12 +15,16 @@ func MaskAPIKey(key string) string {
 	return fmt.Sprintf("%s****%s", key[:4], key[length-4:])
 }
 
-// TruncateString shortens a string to a maximum length.
+// TruncateString shortens a string to a maximum length in bytes.
+// The cut is moved back to a rune boundary so multi-byte UTF-8 characters are never split.
 func TruncateString(s string, maxLength int) string {
-	if len(s) > maxLength {
-		return s[:maxLength]
+	if len(s) <= maxLength {
+		return s
 	}
-	return s
+	for maxLength > 0 && !utf8.RuneStart(s[maxLength]) {
+		maxLength--
+	}
+	return s[:maxLength]
 }
 
 // SplitAndTrim splits a string by a separator